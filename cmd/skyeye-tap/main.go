@@ -0,0 +1,111 @@
+// Command skyeye-tap decodes a skyeye event tap framestream, produced by pkg/tap, and prints
+// each frame to stdout as a line of JSON for use with tools like jq.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"github.com/dharmab/skyeye/pkg/tap"
+)
+
+func main() {
+	filePath := flag.String("file", "", "path to a tap framestream file to decode")
+	unixSocket := flag.String("unix-socket", "", "path to a Unix socket to listen on for a tap connection")
+	flag.Parse()
+
+	if (*filePath == "") == (*unixSocket == "") {
+		fmt.Fprintln(os.Stderr, "skyeye-tap: exactly one of -file or -unix-socket is required")
+		os.Exit(1)
+	}
+
+	var r io.Reader
+	switch {
+	case *filePath != "":
+		file, err := os.Open(*filePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "skyeye-tap: opening %s: %v\n", *filePath, err)
+			os.Exit(1)
+		}
+		defer file.Close()
+		r = file
+	case *unixSocket != "":
+		_ = os.Remove(*unixSocket)
+		listener, err := net.Listen("unix", *unixSocket)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "skyeye-tap: listening on %s: %v\n", *unixSocket, err)
+			os.Exit(1)
+		}
+		defer listener.Close()
+		conn, err := listener.Accept()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "skyeye-tap: accepting connection: %v\n", err)
+			os.Exit(1)
+		}
+		defer conn.Close()
+		r = conn
+	}
+
+	if err := decodeAll(r, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "skyeye-tap: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// decodeAll reads frames from r until EOF, writing one JSON object per line to w.
+func decodeAll(r io.Reader, w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	for {
+		frame, err := tap.ReadFrame(r)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading frame: %w", err)
+		}
+
+		decoded, err := decode(frame)
+		if err != nil {
+			return fmt.Errorf("decoding %s frame: %w", frame.Type, err)
+		}
+		if err := encoder.Encode(decoded); err != nil {
+			return fmt.Errorf("encoding frame as JSON: %w", err)
+		}
+	}
+}
+
+// decode returns a JSON-serializable representation of a single tap frame.
+func decode(frame tap.Frame) (any, error) {
+	envelope := map[string]any{
+		"type":      frame.Type.String(),
+		"timestamp": frame.Timestamp,
+	}
+
+	var (
+		message any
+		err     error
+	)
+	switch frame.Type {
+	case tap.FrameTypeRequestParsed:
+		message, err = tap.UnmarshalRequestParsed(frame.Payload)
+	case tap.FrameTypeResponseComposed:
+		message, err = tap.UnmarshalResponseComposed(frame.Payload)
+	case tap.FrameTypeControllerBroadcast:
+		message, err = tap.UnmarshalControllerBroadcast(frame.Payload)
+	case tap.FrameTypeVoicePacket:
+		message, err = tap.UnmarshalVoicePacket(frame.Payload)
+	default:
+		return nil, fmt.Errorf("unknown frame type %d", frame.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	envelope["message"] = message
+	return envelope, nil
+}
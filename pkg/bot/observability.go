@@ -0,0 +1,162 @@
+// Package bot assembles skyeye's cross-cutting observability subsystems — the structured event
+// tap (pkg/tap), metrics (pkg/metrics), and the gRPC control-plane API (pkg/grpcapi) — from CLI
+// flags, so the bot's entrypoint can turn them on with one call instead of duplicating this
+// wiring. It does not construct the Controller or Composer themselves; those still come from the
+// entrypoint's own DCS World and SRS setup.
+package bot
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/dharmab/skyeye/pkg/composer"
+	"github.com/dharmab/skyeye/pkg/controller"
+	"github.com/dharmab/skyeye/pkg/grpcapi"
+	"github.com/dharmab/skyeye/pkg/metrics"
+	"github.com/dharmab/skyeye/pkg/parser"
+	"github.com/dharmab/skyeye/pkg/simpleradio/audio"
+	"github.com/dharmab/skyeye/pkg/tap"
+	"github.com/rs/zerolog/log"
+)
+
+// ObservabilityFlags holds the CLI flags that gate the tap, metrics, and gRPC control-plane
+// subsystems. Register them with RegisterFlags, parse the flag set as usual, then pass the
+// populated struct to Start once the Controller and Composer are constructed.
+type ObservabilityFlags struct {
+	TapFile       string
+	TapUnixSocket string
+	TapAudio      bool
+	MetricsListen string
+	StatsdAddr    string
+	GRPCListen    string
+	GRPCAuthToken string
+}
+
+// RegisterFlags registers every observability flag on fs.
+func (f *ObservabilityFlags) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.TapFile, "tap-file", "", "path to append structured event tap frames to")
+	fs.StringVar(&f.TapUnixSocket, "tap-unix-socket", "", "Unix socket to stream structured event tap frames to")
+	fs.BoolVar(&f.TapAudio, "tap-audio", false, "include raw audio bytes in tapped voice packets (ignored unless a tap destination is set)")
+	fs.StringVar(&f.MetricsListen, "metrics-listen", "", "address to serve Prometheus metrics on; disabled if empty")
+	fs.StringVar(&f.StatsdAddr, "statsd-addr", "", "address to push statsd metrics to; disabled if empty")
+	fs.StringVar(&f.GRPCListen, "grpc-listen", "", "address to serve the gRPC control-plane API on; disabled if empty")
+	fs.StringVar(&f.GRPCAuthToken, "grpc-auth-token", "", "shared bearer token required by the gRPC control-plane API; required if -grpc-listen is set")
+}
+
+// Start wires up the tap, metrics, and gRPC control-plane subsystems per f, attaching the tap to
+// ctrl, cmp, pkg/parser, and pkg/simpleradio/audio, and (if -grpc-listen is set) serving
+// SkyeyeService backed by ctrl and cmp.
+//
+// calls is the channel ctrl.Run publishes to. Start returns a channel carrying the same calls for
+// the caller's own use (e.g. SRS text-to-speech), since the gRPC server needs its own tee of the
+// channel and the caller can no longer read calls directly afterwards.
+//
+// The returned closer closes the tap sink and the statsd connection, if Start opened them, and
+// should be closed on shutdown. It does not stop the gRPC server, which stops when ctx is
+// canceled, or the Prometheus HTTP server. Start blocks only as long as opening those resources
+// takes; the gRPC server and the tee goroutine run in the background.
+func Start(ctx context.Context, f ObservabilityFlags, ctrl controller.Controller, cmp composer.Composer, calls <-chan controller.Call) (io.Closer, <-chan controller.Call, error) {
+	var closers []io.Closer
+
+	if f.TapFile != "" && f.TapUnixSocket != "" {
+		return nil, nil, fmt.Errorf("bot: at most one of -tap-file or -tap-unix-socket may be set")
+	}
+	var sink tap.Sink
+	switch {
+	case f.TapFile != "":
+		s, err := tap.NewFileSink(f.TapFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		sink = s
+	case f.TapUnixSocket != "":
+		s, err := tap.NewUnixSocketSink(f.TapUnixSocket)
+		if err != nil {
+			return nil, nil, err
+		}
+		sink = s
+	}
+	if sink != nil {
+		closers = append(closers, sink)
+		ctrl.SetTapSink(sink)
+		composer.SetTapSink(sink)
+		parser.SetTapSink(sink)
+		audio.SetTapSink(sink, f.TapAudio)
+	}
+
+	metricsCloser, err := metrics.Start(f.MetricsListen, f.StatsdAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("bot: starting metrics: %w", err)
+	}
+	closers = append(closers, metricsCloser)
+
+	out := calls
+	if f.GRPCListen != "" {
+		if f.GRPCAuthToken == "" {
+			return nil, nil, fmt.Errorf("bot: -grpc-auth-token is required when -grpc-listen is set")
+		}
+		callsOut := make(chan controller.Call, teeQueueDepth)
+		callsForGRPC := make(chan controller.Call, teeQueueDepth)
+		go teeCalls(ctx, calls, callsOut, callsForGRPC)
+
+		server := grpcapi.NewServer(ctrl, callsForGRPC, cmp)
+		go func() {
+			if err := server.ListenAndServe(ctx, f.GRPCListen, f.GRPCAuthToken); err != nil {
+				log.Error().Err(err).Str("addr", f.GRPCListen).Msg("gRPC control-plane API server stopped")
+			}
+		}()
+		out = callsOut
+	}
+
+	return multiCloser(closers), out, nil
+}
+
+// teeQueueDepth bounds how many unsent calls a or b can fall behind by before new calls to it
+// start being dropped, mirroring pkg/grpcapi's broadcaster subscriber queues.
+const teeQueueDepth = 64
+
+// teeCalls copies every call from in to both a and b until in is closed or ctx is done. a and b
+// must be buffered (see teeQueueDepth): sends to each are non-blocking, so a slow or absent
+// reader on one branch is dropped rather than blocking delivery to the other or to in itself.
+func teeCalls(ctx context.Context, in <-chan controller.Call, a, b chan<- controller.Call) {
+	defer close(a)
+	defer close(b)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case call, ok := <-in:
+			if !ok {
+				return
+			}
+			select {
+			case a <- call:
+			default:
+				log.Warn().Msg("dropping call for slow observability tee branch")
+			}
+			select {
+			case b <- call:
+			default:
+				log.Warn().Msg("dropping call for slow observability tee branch")
+			}
+		}
+	}
+}
+
+// multiCloser closes every closer in cs, returning the first error encountered, if any.
+type multiCloser []io.Closer
+
+func (cs multiCloser) Close() error {
+	var firstErr error
+	for _, c := range cs {
+		if c == nil {
+			continue
+		}
+		if err := c.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -1,19 +1,22 @@
 package composer
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/dharmab/skyeye/pkg/brevity"
 	"github.com/rs/zerolog/log"
 )
 
-// ComposeAlphaCheckResponse implements [Composer.ComposeAlphaCheckResponse].
-func (c *composer) ComposeAlphaCheckResponse(response brevity.AlphaCheckResponse) NaturalLanguageResponse {
+// ComposeAlphaCheckResponse implements [Composer.ComposeAlphaCheckResponse]. ctx carries the
+// trace ID of the request this is a response to, for tap correlation.
+func (c *composer) ComposeAlphaCheckResponse(ctx context.Context, response brevity.AlphaCheckResponse) NaturalLanguageResponse {
+	var nlr NaturalLanguageResponse
 	if response.Status {
 		if !response.Location.Bearing().IsMagnetic() {
 			log.Error().Stringer("bearing", response.Location.Bearing()).Msg("bearing provided to ComposeAlphaCheckResponse should be magnetic")
 		}
-		return NaturalLanguageResponse{
+		nlr = NaturalLanguageResponse{
 			Subtitle: fmt.Sprintf(
 				"%s, %s, contact, alpha check bullseye %s/%d",
 				c.ComposeCallsigns(response.Callsign),
@@ -29,11 +32,14 @@ func (c *composer) ComposeAlphaCheckResponse(response brevity.AlphaCheckResponse
 				int(response.Location.Distance().NauticalMiles()),
 			),
 		}
+	} else {
+		reply := response.Callsign + ", negative contact"
+		nlr = NaturalLanguageResponse{
+			Subtitle: reply,
+			Speech:   reply,
+		}
 	}
-
-	reply := response.Callsign + ", negative contact"
-	return NaturalLanguageResponse{
-		Subtitle: reply,
-		Speech:   reply,
-	}
+	recordComposed("ComposeAlphaCheckResponse")
+	emitResponseComposed(ctx, "ComposeAlphaCheckResponse", nlr)
+	return nlr
 }
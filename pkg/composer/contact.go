@@ -1,6 +1,7 @@
 package composer
 
 import (
+	"context"
 	"fmt"
 	"math/rand/v2"
 
@@ -8,7 +9,8 @@ import (
 )
 
 // ComposeNegativeRadarContactResponse implements [Composer.ComposeNegativeRadarContactResponse].
-func (c *composer) ComposeNegativeRadarContactResponse(response brevity.NegativeRadarContactResponse) NaturalLanguageResponse {
+// ctx carries the trace ID of the request this is a response to, for tap correlation.
+func (c *composer) ComposeNegativeRadarContactResponse(ctx context.Context, response brevity.NegativeRadarContactResponse) NaturalLanguageResponse {
 	replies := []string{
 		"%s, negative radar contact. Double check your callsign.",
 		"%s, negative radar contact. Check your callsign.",
@@ -25,8 +27,11 @@ func (c *composer) ComposeNegativeRadarContactResponse(response brevity.Negative
 		"%s, negative radar contact. I do not have that callsign on scope.",
 	}
 	reply := fmt.Sprintf(replies[rand.IntN(len(replies))], c.ComposeCallsigns(response.Callsign))
-	return NaturalLanguageResponse{
+	nlr := NaturalLanguageResponse{
 		Subtitle: reply,
 		Speech:   reply,
 	}
+	recordComposed("ComposeNegativeRadarContactResponse")
+	emitResponseComposed(ctx, "ComposeNegativeRadarContactResponse", nlr)
+	return nlr
 }
@@ -0,0 +1,9 @@
+package composer
+
+import "github.com/dharmab/skyeye/pkg/metrics"
+
+// recordComposed increments the counter tracking how many times method has produced a response,
+// so operators can see the mix of calls being produced.
+func recordComposed(method string) {
+	metrics.IncrCounter([]string{"composer", "composed", method}, 1)
+}
@@ -0,0 +1,47 @@
+package composer
+
+import (
+	"context"
+	"time"
+
+	"github.com/dharmab/skyeye/pkg/tap"
+	"github.com/dharmab/skyeye/pkg/traces"
+	"github.com/rs/zerolog/log"
+)
+
+// tapSink receives a copy of every response composed by ComposeAlphaCheckResponse,
+// ComposeNegativeRadarContactResponse, and ComposeThreatCall, for offline analysis; other Compose*
+// methods do not report to it. It defaults to [tap.Noop] so composing a response costs nothing
+// extra until an operator attaches a sink.
+var tapSink tap.Sink = tap.Noop
+
+// SetTapSink attaches sink as the destination for [tap.ResponseComposed] events. Pass
+// [tap.Noop] to detach.
+func SetTapSink(sink tap.Sink) {
+	if sink == nil {
+		sink = tap.Noop
+	}
+	tapSink = sink
+}
+
+// emitResponseComposed reports a composed response to the attached tap sink, if any. method is
+// the name of the Compose* method that produced response, e.g. "ComposeAlphaCheckResponse". ctx
+// carries the trace ID of the request or broadcast that triggered the response, so the emitted
+// event can be correlated back to it.
+func emitResponseComposed(ctx context.Context, method string, response NaturalLanguageResponse) {
+	if !tap.IsEnabled(tapSink) {
+		return
+	}
+	if err := tapSink.Write(tap.Frame{
+		Type:      tap.FrameTypeResponseComposed,
+		Timestamp: time.Now(),
+		Payload: tap.ResponseComposed{
+			TraceID:  traces.TraceID(ctx),
+			Method:   method,
+			Subtitle: response.Subtitle,
+			Speech:   response.Speech,
+		}.Marshal(),
+	}); err != nil {
+		log.Warn().Err(err).Str("method", method).Msg("failed to write tap frame")
+	}
+}
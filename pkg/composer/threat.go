@@ -1,18 +1,23 @@
 package composer
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/dharmab/skyeye/pkg/brevity"
 )
 
-// ComposeThreatCall implements [Composer.ComposeThreatCall].
-func (c *composer) ComposeThreatCall(call brevity.ThreatCall) NaturalLanguageResponse {
+// ComposeThreatCall implements [Composer.ComposeThreatCall]. ctx carries the trace ID of the
+// broadcast this call is for, for tap correlation.
+func (c *composer) ComposeThreatCall(ctx context.Context, call brevity.ThreatCall) NaturalLanguageResponse {
 	group := c.ComposeGroup(call.Group)
 	callsignList := c.ComposeCallsigns(call.Callsigns...)
-	return NaturalLanguageResponse{
+	nlr := NaturalLanguageResponse{
 		Subtitle: fmt.Sprintf("%s, %s", callsignList, applyToFirstCharacter(group.Subtitle, strings.ToLower)),
 		Speech:   fmt.Sprintf("%s, %s", callsignList, group.Speech),
 	}
+	recordComposed("ComposeThreatCall")
+	emitResponseComposed(ctx, "ComposeThreatCall", nlr)
+	return nlr
 }
@@ -3,12 +3,15 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/dharmab/skyeye/pkg/brevity"
 	"github.com/dharmab/skyeye/pkg/coalitions"
+	"github.com/dharmab/skyeye/pkg/metrics"
 	"github.com/dharmab/skyeye/pkg/radar"
 	"github.com/dharmab/skyeye/pkg/simpleradio"
+	"github.com/dharmab/skyeye/pkg/tap"
 	"github.com/dharmab/skyeye/pkg/traces"
 	"github.com/dharmab/skyeye/pkg/trackfiles"
 	"github.com/lithammer/shortuuid/v3"
@@ -38,6 +41,15 @@ type Controller interface {
 	// Run starts the controller's control loops. It should be called exactly once. It blocks until the context is canceled.
 	// The controller publishes responses to the given channel.
 	Run(ctx context.Context, out chan<- Call)
+	// SetTapSink attaches sink as the destination for [tap.ControllerBroadcast] events describing
+	// automatic picture, threat, merge, and sunrise broadcasts. Pass [tap.Noop] to detach.
+	SetTapSink(sink tap.Sink)
+	// RequestPicture immediately broadcasts a tactical air picture, equivalent to a player
+	// calling PICTURE over voice. It is intended for external callers such as pkg/grpcapi.
+	RequestPicture(ctx context.Context)
+	// SetThreatMonitoring enables or disables automatic threat calls at runtime. If cooldown is
+	// non-zero, it also replaces the interval between threat calls for the same threat.
+	SetThreatMonitoring(enabled bool, cooldown time.Duration)
 	// HandleAlphaCheck handles an ALPHA CHECK by reporting the position of the requesting aircraft.
 	HandleAlphaCheck(context.Context, *brevity.AlphaCheckRequest)
 	// HandleBogeyDope handles a BOGEY DOPE by reporting the closest enemy group to the requesting aircraft.
@@ -96,6 +108,10 @@ type controller struct {
 
 	// calls is the channel to publish responses and calls to.
 	calls chan<- Call
+
+	// tapSink receives a copy of every automatic broadcast, for offline analysis. It defaults to
+	// [tap.Noop], so no tap frame is encoded or written until an operator attaches a sink.
+	tapSink tap.Sink
 }
 
 func New(
@@ -108,7 +124,7 @@ func New(
 	threatMonitoringCooldown time.Duration,
 	threatMonitoringRequiresSRS bool,
 ) Controller {
-	return &controller{
+	return instrumentedController{Controller: &controller{
 		coalition:                   coalition,
 		scope:                       rdr,
 		srsClient:                   srsClient,
@@ -120,10 +136,62 @@ func New(
 		threatCooldowns:             newCooldownTracker(threatMonitoringCooldown),
 		threatMonitoringRequiresSRS: threatMonitoringRequiresSRS,
 		merges:                      newMergeTracker(),
+		tapSink:                     tap.Noop,
+	}}
+}
+
+// SetTapSink implements [Controller.SetTapSink].
+func (c *controller) SetTapSink(sink tap.Sink) {
+	if sink == nil {
+		sink = tap.Noop
+	}
+	c.tapSink = sink
+}
+
+// RequestPicture implements [Controller.RequestPicture].
+func (c *controller) RequestPicture(ctx context.Context) {
+	c.broadcastPicture(traces.WithTraceID(ctx, shortuuid.New()), &log.Logger, false)
+}
+
+// SetThreatMonitoring implements [Controller.SetThreatMonitoring].
+func (c *controller) SetThreatMonitoring(enabled bool, cooldown time.Duration) {
+	c.enableThreatMonitoring = enabled
+	if cooldown > 0 {
+		c.threatMonitoringCooldown = cooldown
+		c.threatCooldowns = newCooldownTracker(cooldown)
+	}
+}
+
+// emitBroadcast reports an automatic broadcast to the attached tap sink, if any. kind identifies
+// the broadcast type, e.g. "picture", "threat", "merge", "sunrise". Of these, only threat calls
+// have their natural-language text tapped, by the composer's ComposeThreatCall via
+// [tap.FrameTypeResponseComposed]. This event records only that the controller decided to
+// broadcast, and why: detail goes in the Speech field and Subtitle is left empty.
+func (c *controller) emitBroadcast(ctx context.Context, kind string, detail string) {
+	if !tap.IsEnabled(c.tapSink) {
+		return
+	}
+	if err := c.tapSink.Write(tap.Frame{
+		Type:      tap.FrameTypeControllerBroadcast,
+		Timestamp: time.Now(),
+		Payload: tap.ControllerBroadcast{
+			TraceID: traces.TraceID(ctx),
+			Kind:    kind,
+			Speech:  detail,
+		}.Marshal(),
+	}); err != nil {
+		log.Warn().Err(err).Str("kind", kind).Msg("failed to write tap frame")
 	}
 }
 
 // Run implements [Controller.Run].
+//
+// The "threat suppressed, SRS not on frequency" and "picture skipped, clean" counters below are
+// necessarily approximations: the per-threat SRS check and the clean-picture skip decision
+// happen inside broadcastThreats/broadcastPicture themselves, which Run has no visibility into
+// beyond calling them. Run instead infers the same condition from state it does have: zero SRS
+// frequencies connected implies every threat this sweep was suppressed for lack of an audience,
+// and c.wasLastPictureClean reflects whether the last broadcast picture had nothing to report.
 func (c *controller) Run(ctx context.Context, calls chan<- Call) {
 	c.calls = calls
 
@@ -145,21 +213,51 @@ func (c *controller) Run(ctx context.Context, calls chan<- Call) {
 			c.scope.SetStartedCallback(nil)
 			return
 		case <-ticker.C:
-			c.broadcastMerges(traces.WithTraceID(ctx, shortuuid.New()))
-			c.broadcastThreats(traces.WithTraceID(ctx, shortuuid.New()))
+			threatCooldowns := c.threatCooldowns.len()
+			merges := c.merges.len()
+			metrics.SetGauge([]string{"controller", "threat_cooldowns"}, float32(threatCooldowns))
+			metrics.SetGauge([]string{"controller", "merges"}, float32(merges))
+
+			mergeCtx := traces.WithTraceID(ctx, shortuuid.New())
+			c.broadcastMerges(mergeCtx)
+			// broadcastMerges decides for itself, per tracked contact, whether to actually speak;
+			// merges being non-zero is the best signal available here (short of instrumenting
+			// broadcastMerges itself) that this tick's sweep was likely to have said something.
+			if merges > 0 {
+				c.emitBroadcast(mergeCtx, "merge", fmt.Sprintf("merge sweep with %d contact(s) merged", merges))
+			}
+
+			threatCtx := traces.WithTraceID(ctx, shortuuid.New())
+			if c.threatMonitoringRequiresSRS && len(c.srsClient.Frequencies()) == 0 {
+				metrics.IncrCounter([]string{"controller", "threat", "suppressed", "no_srs"}, 1)
+			}
+			c.broadcastThreats(threatCtx)
+			if threatCooldowns > 0 {
+				c.emitBroadcast(threatCtx, "threat", fmt.Sprintf("threat sweep with %d cooldown(s) active", threatCooldowns))
+			}
+
 			if c.enableAutomaticPicture && time.Now().After(c.pictureBroadcastDeadline) {
-				c.broadcastPicture(traces.WithTraceID(ctx, shortuuid.New()), &log.Logger, false)
+				if c.wasLastPictureClean {
+					metrics.IncrCounter([]string{"controller", "picture", "skipped", "clean"}, 1)
+				}
+				pictureCtx := traces.WithTraceID(ctx, shortuuid.New())
+				c.emitBroadcast(pictureCtx, "picture", "automatic picture due")
+				c.broadcastPicture(pictureCtx, &log.Logger, false)
+			} else if c.enableAutomaticPicture {
+				metrics.IncrCounter([]string{"controller", "picture", "skipped", "not_due"}, 1)
 			}
 		}
 	}
 }
 
 func (c *controller) broadcastSunrise(ctx context.Context) {
+	ctx = traces.WithTraceID(ctx, shortuuid.New())
 	frequencies := make([]unit.Frequency, 0)
 	for _, rf := range c.srsClient.Frequencies() {
 		frequencies = append(frequencies, rf.Frequency)
 	}
-	c.calls <- NewCall(traces.WithTraceID(ctx, shortuuid.New()), brevity.SunriseCall{Frequencies: frequencies})
+	c.emitBroadcast(ctx, "sunrise", fmt.Sprintf("sunrise on %d frequencies", len(frequencies)))
+	c.calls <- NewCall(ctx, brevity.SunriseCall{Frequencies: frequencies})
 }
 
 // findCallsign uses fuzzy matching to find a trackfile for the given callsign.
@@ -191,3 +289,73 @@ func (c *controller) reset() {
 	c.threatCooldowns.reset()
 	c.merges.reset()
 }
+
+// instrumentedController wraps a Controller, recording a call counter and a time-to-respond
+// histogram for each Handle* method, keyed by method name. It's a decorator rather than logic
+// built into controller itself so that every Handle* implementation gets identical, consistent
+// instrumentation without each one needing to remember to add it.
+type instrumentedController struct {
+	Controller
+}
+
+// recordHandle increments the call counter for method and adds the elapsed time since start, in
+// milliseconds, to its time-to-respond histogram.
+func recordHandle(method string, start time.Time) {
+	metrics.IncrCounter([]string{"controller", "handle", method, "count"}, 1)
+	metrics.AddSample([]string{"controller", "handle", method, "latency_ms"}, float32(time.Since(start).Milliseconds()))
+}
+
+func (c instrumentedController) HandleAlphaCheck(ctx context.Context, req *brevity.AlphaCheckRequest) {
+	defer recordHandle("HandleAlphaCheck", time.Now())
+	c.Controller.HandleAlphaCheck(ctx, req)
+}
+
+func (c instrumentedController) HandleBogeyDope(ctx context.Context, req *brevity.BogeyDopeRequest) {
+	defer recordHandle("HandleBogeyDope", time.Now())
+	c.Controller.HandleBogeyDope(ctx, req)
+}
+
+func (c instrumentedController) HandleCheckIn(ctx context.Context, req *brevity.CheckInRequest) {
+	defer recordHandle("HandleCheckIn", time.Now())
+	c.Controller.HandleCheckIn(ctx, req)
+}
+
+func (c instrumentedController) HandleDeclare(ctx context.Context, req *brevity.DeclareRequest) {
+	defer recordHandle("HandleDeclare", time.Now())
+	c.Controller.HandleDeclare(ctx, req)
+}
+
+func (c instrumentedController) HandlePicture(ctx context.Context, req *brevity.PictureRequest) {
+	defer recordHandle("HandlePicture", time.Now())
+	c.Controller.HandlePicture(ctx, req)
+}
+
+func (c instrumentedController) HandleRadioCheck(ctx context.Context, req *brevity.RadioCheckRequest) {
+	defer recordHandle("HandleRadioCheck", time.Now())
+	c.Controller.HandleRadioCheck(ctx, req)
+}
+
+func (c instrumentedController) HandleShopping(ctx context.Context, req *brevity.ShoppingRequest) {
+	defer recordHandle("HandleShopping", time.Now())
+	c.Controller.HandleShopping(ctx, req)
+}
+
+func (c instrumentedController) HandleSnaplock(ctx context.Context, req *brevity.SnaplockRequest) {
+	defer recordHandle("HandleSnaplock", time.Now())
+	c.Controller.HandleSnaplock(ctx, req)
+}
+
+func (c instrumentedController) HandleSpiked(ctx context.Context, req *brevity.SpikedRequest) {
+	defer recordHandle("HandleSpiked", time.Now())
+	c.Controller.HandleSpiked(ctx, req)
+}
+
+func (c instrumentedController) HandleTripwire(ctx context.Context, req *brevity.TripwireRequest) {
+	defer recordHandle("HandleTripwire", time.Now())
+	c.Controller.HandleTripwire(ctx, req)
+}
+
+func (c instrumentedController) HandleUnableToUnderstand(ctx context.Context, req *brevity.UnableToUnderstandRequest) {
+	defer recordHandle("HandleUnableToUnderstand", time.Now())
+	c.Controller.HandleUnableToUnderstand(ctx, req)
+}
@@ -0,0 +1,70 @@
+package grpcapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const authMetadataKey = "authorization"
+
+// tokenAuth enforces a shared-token bearer check on every RPC, so SkyeyeService can be exposed on
+// non-loopback interfaces via --grpc-listen without granting anonymous control of the bot.
+type tokenAuth struct {
+	token string
+}
+
+func (a tokenAuth) authenticate(ctx context.Context) error {
+	if a.token == "" {
+		// An empty configured token must never authenticate anything: without this check, a
+		// client sending "Authorization: Bearer " (empty value) would compare equal to an empty
+		// a.token and be let through with no real credential at all.
+		return status.Error(codes.Unauthenticated, "server has no auth token configured")
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	values := md.Get(authMetadataKey)
+	if len(values) != 1 {
+		return status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+	got, found := strings.CutPrefix(values[0], "Bearer ")
+	if !found {
+		return status.Error(codes.Unauthenticated, "malformed authorization header")
+	}
+	if subtle.ConstantTimeCompare([]byte(got), []byte(a.token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	return nil
+}
+
+// UnaryInterceptor rejects unary RPCs that don't carry a valid shared bearer token.
+func (a tokenAuth) UnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := a.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor rejects streaming RPCs that don't carry a valid shared bearer token.
+func (a tokenAuth) StreamInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authenticate(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// ServerOptions returns the grpc.ServerOptions needed to enforce token authentication on both
+// unary and streaming RPCs.
+func (a tokenAuth) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(a.UnaryInterceptor),
+		grpc.StreamInterceptor(a.StreamInterceptor),
+	}
+}
@@ -0,0 +1,58 @@
+package grpcapi
+
+import (
+	"sync"
+
+	"github.com/dharmab/skyeye/pkg/controller"
+	"github.com/rs/zerolog/log"
+)
+
+// subscriberQueueDepth bounds how many unsent calls a single Stream subscriber can fall behind
+// by before new calls to it start being dropped.
+const subscriberQueueDepth = 64
+
+// broadcaster fans out every controller.Call published on a single channel to any number of
+// Stream subscribers, each via its own buffered queue, so a slow gRPC client cannot block
+// controller.Run's calls channel.
+type broadcaster struct {
+	mu          sync.Mutex
+	subscribers map[int]chan controller.Call
+	nextID      int
+}
+
+func newBroadcaster() *broadcaster {
+	return &broadcaster{subscribers: make(map[int]chan controller.Call)}
+}
+
+// run reads from in until it is closed, fanning out every call to each subscriber. It should be
+// started in its own goroutine, fed by a tee of the channel passed to controller.Run.
+func (b *broadcaster) run(in <-chan controller.Call) {
+	for call := range in {
+		b.mu.Lock()
+		for id, sub := range b.subscribers {
+			select {
+			case sub <- call:
+			default:
+				log.Warn().Int("subscriber", id).Msg("dropping call for slow gRPC stream subscriber")
+			}
+		}
+		b.mu.Unlock()
+	}
+}
+
+// subscribe registers a new subscriber and returns its queue and an unsubscribe function. The
+// queue is closed once unsubscribe is called.
+func (b *broadcaster) subscribe() (<-chan controller.Call, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	id := b.nextID
+	b.nextID++
+	queue := make(chan controller.Call, subscriberQueueDepth)
+	b.subscribers[id] = queue
+	return queue, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers, id)
+		close(queue)
+	}
+}
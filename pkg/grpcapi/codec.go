@@ -0,0 +1,50 @@
+package grpcapi
+
+import "fmt"
+
+// marshaler and unmarshaler are implemented by every message type used on SkyeyeService,
+// matching the Marshal/Unmarshal convention established by pkg/wire and pkg/tap.
+type marshaler interface {
+	Marshal() []byte
+}
+
+type unmarshaler interface {
+	Unmarshal([]byte) error
+}
+
+// codec is a minimal grpc/encoding.Codec for SkyeyeService's hand-rolled protobuf-wire-format
+// messages (see api/v1/skyeye.proto), matching the approach used for pkg/tap.
+//
+// This is a stopgap, not the intended end state: the point of putting SkyeyeService behind a
+// real .proto is to let third-party tools generate a client in whatever language they use, and
+// that only works against protoc-gen-go/protoc-gen-go-grpc output registered under the standard
+// "proto" codec name. Every message type's Marshal/Unmarshal here is written to produce and
+// accept byte-exact standard protobuf wire format for the schema in api/v1/skyeye.proto (correct
+// field numbers, fixed64 for double, no bespoke framing), so a real protoc-generated client still
+// interoperates at the wire level even though this server doesn't link protoc-gen-go output.
+// Replace this file and service.go with generated stubs once a protoc toolchain is available in
+// the build; nothing about the wire format needs to change to do so.
+type codec struct{}
+
+// Marshal implements encoding.Codec.
+func (codec) Marshal(v any) ([]byte, error) {
+	m, ok := v.(marshaler)
+	if !ok {
+		return nil, fmt.Errorf("grpcapi: %T does not implement Marshal", v)
+	}
+	return m.Marshal(), nil
+}
+
+// Unmarshal implements encoding.Codec.
+func (codec) Unmarshal(data []byte, v any) error {
+	m, ok := v.(unmarshaler)
+	if !ok {
+		return fmt.Errorf("grpcapi: %T does not implement Unmarshal", v)
+	}
+	return m.Unmarshal(data)
+}
+
+// Name implements encoding.Codec.
+func (codec) Name() string {
+	return "skyeyepb"
+}
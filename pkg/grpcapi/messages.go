@@ -0,0 +1,413 @@
+package grpcapi
+
+import (
+	"math"
+
+	"github.com/dharmab/skyeye/pkg/wire"
+)
+
+// BrevityRequest mirrors skyeye.v1.BrevityRequest: a trace_id plus a oneof of the eight typed
+// sub-request messages (AlphaCheckRequest, BogeyDopeRequest, DeclareRequest, SnaplockRequest,
+// SpikedRequest, PictureRequest, RadioCheckRequest, TripwireRequest). Kind identifies which
+// alternative was set on the wire: "alpha_check", "bogey_dope", "declare", "snaplock", "spiked",
+// "picture", "radio_check", or "tripwire". Callsign, BearingDegrees, and RangeNM are the fields
+// of whichever sub-request Kind names, flattened here for dispatch's convenience; BearingDegrees
+// and RangeNM are only meaningful for "declare", "snaplock" (both fields), and "spiked" (bearing
+// only), matching DeclareRequest/SnaplockRequest/SpikedRequest in api/v1/skyeye.proto, and zero
+// for every other kind.
+type BrevityRequest struct {
+	TraceID        string
+	Kind           string
+	Callsign       string
+	BearingDegrees float64
+	RangeNM        float64
+}
+
+// Marshal encodes m per skyeye.v1.BrevityRequest, embedding the sub-request m.Kind selects as a
+// length-delimited submessage field, matching the proto's oneof layout.
+func (m *BrevityRequest) Marshal() []byte {
+	buf := wire.AppendString(nil, 1, m.TraceID)
+	switch m.Kind {
+	case "alpha_check":
+		buf = wire.AppendBytes(buf, 2, (&AlphaCheckRequest{Callsign: m.Callsign}).Marshal())
+	case "bogey_dope":
+		buf = wire.AppendBytes(buf, 3, (&BogeyDopeRequest{Callsign: m.Callsign}).Marshal())
+	case "declare":
+		buf = wire.AppendBytes(buf, 4, (&DeclareRequest{Callsign: m.Callsign, BearingDegrees: m.BearingDegrees, RangeNM: m.RangeNM}).Marshal())
+	case "snaplock":
+		buf = wire.AppendBytes(buf, 5, (&SnaplockRequest{Callsign: m.Callsign, BearingDegrees: m.BearingDegrees, RangeNM: m.RangeNM}).Marshal())
+	case "spiked":
+		buf = wire.AppendBytes(buf, 6, (&SpikedRequest{Callsign: m.Callsign, BearingDegrees: m.BearingDegrees}).Marshal())
+	case "picture":
+		buf = wire.AppendBytes(buf, 7, (&PictureRequest{Callsign: m.Callsign}).Marshal())
+	case "radio_check":
+		buf = wire.AppendBytes(buf, 8, (&RadioCheckRequest{Callsign: m.Callsign}).Marshal())
+	case "tripwire":
+		buf = wire.AppendBytes(buf, 9, (&TripwireRequest{Callsign: m.Callsign}).Marshal())
+	}
+	return buf
+}
+
+// Unmarshal decodes b per skyeye.v1.BrevityRequest, flattening whichever oneof submessage field
+// is present into Kind and the sub-request's own fields.
+func (m *BrevityRequest) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, val []byte, _ uint64) error {
+		switch field {
+		case 1:
+			m.TraceID = string(val)
+		case 2:
+			var req AlphaCheckRequest
+			if err := req.Unmarshal(val); err != nil {
+				return err
+			}
+			m.Kind, m.Callsign = "alpha_check", req.Callsign
+		case 3:
+			var req BogeyDopeRequest
+			if err := req.Unmarshal(val); err != nil {
+				return err
+			}
+			m.Kind, m.Callsign = "bogey_dope", req.Callsign
+		case 4:
+			var req DeclareRequest
+			if err := req.Unmarshal(val); err != nil {
+				return err
+			}
+			m.Kind, m.Callsign, m.BearingDegrees, m.RangeNM = "declare", req.Callsign, req.BearingDegrees, req.RangeNM
+		case 5:
+			var req SnaplockRequest
+			if err := req.Unmarshal(val); err != nil {
+				return err
+			}
+			m.Kind, m.Callsign, m.BearingDegrees, m.RangeNM = "snaplock", req.Callsign, req.BearingDegrees, req.RangeNM
+		case 6:
+			var req SpikedRequest
+			if err := req.Unmarshal(val); err != nil {
+				return err
+			}
+			m.Kind, m.Callsign, m.BearingDegrees = "spiked", req.Callsign, req.BearingDegrees
+		case 7:
+			var req PictureRequest
+			if err := req.Unmarshal(val); err != nil {
+				return err
+			}
+			m.Kind, m.Callsign = "picture", req.Callsign
+		case 8:
+			var req RadioCheckRequest
+			if err := req.Unmarshal(val); err != nil {
+				return err
+			}
+			m.Kind, m.Callsign = "radio_check", req.Callsign
+		case 9:
+			var req TripwireRequest
+			if err := req.Unmarshal(val); err != nil {
+				return err
+			}
+			m.Kind, m.Callsign = "tripwire", req.Callsign
+		}
+		return nil
+	})
+}
+
+// AlphaCheckRequest mirrors skyeye.v1.AlphaCheckRequest.
+type AlphaCheckRequest struct {
+	Callsign string
+}
+
+// Marshal encodes m per skyeye.v1.AlphaCheckRequest.
+func (m *AlphaCheckRequest) Marshal() []byte {
+	return wire.AppendString(nil, 1, m.Callsign)
+}
+
+// Unmarshal decodes b per skyeye.v1.AlphaCheckRequest.
+func (m *AlphaCheckRequest) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, val []byte, _ uint64) error {
+		if field == 1 {
+			m.Callsign = string(val)
+		}
+		return nil
+	})
+}
+
+// BogeyDopeRequest mirrors skyeye.v1.BogeyDopeRequest.
+type BogeyDopeRequest struct {
+	Callsign string
+}
+
+// Marshal encodes m per skyeye.v1.BogeyDopeRequest.
+func (m *BogeyDopeRequest) Marshal() []byte {
+	return wire.AppendString(nil, 1, m.Callsign)
+}
+
+// Unmarshal decodes b per skyeye.v1.BogeyDopeRequest.
+func (m *BogeyDopeRequest) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, val []byte, _ uint64) error {
+		if field == 1 {
+			m.Callsign = string(val)
+		}
+		return nil
+	})
+}
+
+// DeclareRequest mirrors skyeye.v1.DeclareRequest. BearingDegrees and RangeNM locate the target
+// group relative to the requesting aircraft, analogous to a DECLARE call's bearing/range group.
+type DeclareRequest struct {
+	Callsign       string
+	BearingDegrees float64
+	RangeNM        float64
+}
+
+// Marshal encodes m per skyeye.v1.DeclareRequest.
+func (m *DeclareRequest) Marshal() []byte {
+	buf := wire.AppendString(nil, 1, m.Callsign)
+	buf = wire.AppendDouble(buf, 2, m.BearingDegrees)
+	buf = wire.AppendDouble(buf, 3, m.RangeNM)
+	return buf
+}
+
+// Unmarshal decodes b per skyeye.v1.DeclareRequest.
+func (m *DeclareRequest) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, val []byte, uval uint64) error {
+		switch field {
+		case 1:
+			m.Callsign = string(val)
+		case 2:
+			m.BearingDegrees = math.Float64frombits(uval)
+		case 3:
+			m.RangeNM = math.Float64frombits(uval)
+		}
+		return nil
+	})
+}
+
+// SnaplockRequest mirrors skyeye.v1.SnaplockRequest.
+type SnaplockRequest struct {
+	Callsign       string
+	BearingDegrees float64
+	RangeNM        float64
+}
+
+// Marshal encodes m per skyeye.v1.SnaplockRequest.
+func (m *SnaplockRequest) Marshal() []byte {
+	buf := wire.AppendString(nil, 1, m.Callsign)
+	buf = wire.AppendDouble(buf, 2, m.BearingDegrees)
+	buf = wire.AppendDouble(buf, 3, m.RangeNM)
+	return buf
+}
+
+// Unmarshal decodes b per skyeye.v1.SnaplockRequest.
+func (m *SnaplockRequest) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, val []byte, uval uint64) error {
+		switch field {
+		case 1:
+			m.Callsign = string(val)
+		case 2:
+			m.BearingDegrees = math.Float64frombits(uval)
+		case 3:
+			m.RangeNM = math.Float64frombits(uval)
+		}
+		return nil
+	})
+}
+
+// SpikedRequest mirrors skyeye.v1.SpikedRequest.
+type SpikedRequest struct {
+	Callsign       string
+	BearingDegrees float64
+}
+
+// Marshal encodes m per skyeye.v1.SpikedRequest.
+func (m *SpikedRequest) Marshal() []byte {
+	buf := wire.AppendString(nil, 1, m.Callsign)
+	buf = wire.AppendDouble(buf, 2, m.BearingDegrees)
+	return buf
+}
+
+// Unmarshal decodes b per skyeye.v1.SpikedRequest.
+func (m *SpikedRequest) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, val []byte, uval uint64) error {
+		switch field {
+		case 1:
+			m.Callsign = string(val)
+		case 2:
+			m.BearingDegrees = math.Float64frombits(uval)
+		}
+		return nil
+	})
+}
+
+// PictureRequest mirrors skyeye.v1.PictureRequest, the BrevityRequest oneof alternative. Not to
+// be confused with [RequestPictureRequest], the unrelated unary RequestPicture RPC's request.
+type PictureRequest struct {
+	Callsign string
+}
+
+// Marshal encodes m per skyeye.v1.PictureRequest.
+func (m *PictureRequest) Marshal() []byte {
+	return wire.AppendString(nil, 1, m.Callsign)
+}
+
+// Unmarshal decodes b per skyeye.v1.PictureRequest.
+func (m *PictureRequest) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, val []byte, _ uint64) error {
+		if field == 1 {
+			m.Callsign = string(val)
+		}
+		return nil
+	})
+}
+
+// RadioCheckRequest mirrors skyeye.v1.RadioCheckRequest.
+type RadioCheckRequest struct {
+	Callsign string
+}
+
+// Marshal encodes m per skyeye.v1.RadioCheckRequest.
+func (m *RadioCheckRequest) Marshal() []byte {
+	return wire.AppendString(nil, 1, m.Callsign)
+}
+
+// Unmarshal decodes b per skyeye.v1.RadioCheckRequest.
+func (m *RadioCheckRequest) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, val []byte, _ uint64) error {
+		if field == 1 {
+			m.Callsign = string(val)
+		}
+		return nil
+	})
+}
+
+// TripwireRequest mirrors skyeye.v1.TripwireRequest.
+type TripwireRequest struct {
+	Callsign string
+}
+
+// Marshal encodes m per skyeye.v1.TripwireRequest.
+func (m *TripwireRequest) Marshal() []byte {
+	return wire.AppendString(nil, 1, m.Callsign)
+}
+
+// Unmarshal decodes b per skyeye.v1.TripwireRequest.
+func (m *TripwireRequest) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, val []byte, _ uint64) error {
+		if field == 1 {
+			m.Callsign = string(val)
+		}
+		return nil
+	})
+}
+
+// Call mirrors skyeye.v1.Call: a published controller response or broadcast.
+type Call struct {
+	TraceID  string
+	Kind     string
+	Subtitle string
+	Speech   string
+}
+
+// Marshal encodes m per skyeye.v1.Call.
+func (m *Call) Marshal() []byte {
+	var buf []byte
+	buf = wire.AppendString(buf, 1, m.TraceID)
+	buf = wire.AppendString(buf, 2, m.Kind)
+	buf = wire.AppendString(buf, 3, m.Subtitle)
+	buf = wire.AppendString(buf, 4, m.Speech)
+	return buf
+}
+
+// Unmarshal decodes b per skyeye.v1.Call.
+func (m *Call) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, val []byte, _ uint64) error {
+		switch field {
+		case 1:
+			m.TraceID = string(val)
+		case 2:
+			m.Kind = string(val)
+		case 3:
+			m.Subtitle = string(val)
+		case 4:
+			m.Speech = string(val)
+		}
+		return nil
+	})
+}
+
+// RequestPictureRequest mirrors skyeye.v1.RequestPictureRequest.
+type RequestPictureRequest struct {
+	TraceID string
+}
+
+// Marshal encodes m per skyeye.v1.RequestPictureRequest.
+func (m *RequestPictureRequest) Marshal() []byte {
+	return wire.AppendString(nil, 1, m.TraceID)
+}
+
+// Unmarshal decodes b per skyeye.v1.RequestPictureRequest.
+func (m *RequestPictureRequest) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, val []byte, _ uint64) error {
+		if field == 1 {
+			m.TraceID = string(val)
+		}
+		return nil
+	})
+}
+
+// SetThreatMonitoringRequest mirrors skyeye.v1.SetThreatMonitoringRequest.
+type SetThreatMonitoringRequest struct {
+	Enabled         bool
+	CooldownSeconds uint32
+}
+
+// Marshal encodes m per skyeye.v1.SetThreatMonitoringRequest.
+func (m *SetThreatMonitoringRequest) Marshal() []byte {
+	var buf []byte
+	buf = wire.AppendVarint(buf, 1, boolToVarint(m.Enabled))
+	buf = wire.AppendVarint(buf, 2, uint64(m.CooldownSeconds))
+	return buf
+}
+
+// Unmarshal decodes b per skyeye.v1.SetThreatMonitoringRequest.
+func (m *SetThreatMonitoringRequest) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, _ []byte, uval uint64) error {
+		switch field {
+		case 1:
+			m.Enabled = uval != 0
+		case 2:
+			m.CooldownSeconds = uint32(uval)
+		}
+		return nil
+	})
+}
+
+// SetThreatMonitoringResponse mirrors skyeye.v1.SetThreatMonitoringResponse.
+type SetThreatMonitoringResponse struct {
+	Enabled         bool
+	CooldownSeconds uint32
+}
+
+// Marshal encodes m per skyeye.v1.SetThreatMonitoringResponse.
+func (m *SetThreatMonitoringResponse) Marshal() []byte {
+	var buf []byte
+	buf = wire.AppendVarint(buf, 1, boolToVarint(m.Enabled))
+	buf = wire.AppendVarint(buf, 2, uint64(m.CooldownSeconds))
+	return buf
+}
+
+// Unmarshal decodes b per skyeye.v1.SetThreatMonitoringResponse.
+func (m *SetThreatMonitoringResponse) Unmarshal(b []byte) error {
+	return wire.WalkFields(b, func(field, _ int, _ []byte, uval uint64) error {
+		switch field {
+		case 1:
+			m.Enabled = uval != 0
+		case 2:
+			m.CooldownSeconds = uint32(uval)
+		}
+		return nil
+	})
+}
+
+func boolToVarint(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,185 @@
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/dharmab/skyeye/pkg/brevity"
+	"github.com/dharmab/skyeye/pkg/composer"
+	"github.com/dharmab/skyeye/pkg/controller"
+	"github.com/dharmab/skyeye/pkg/traces"
+	"github.com/lithammer/shortuuid/v3"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+)
+
+// Server implements [SkyeyeServiceServer], exposing a controller.Controller over gRPC.
+type Server struct {
+	ctrl        controller.Controller
+	composer    composer.Composer
+	broadcaster *broadcaster
+}
+
+// NewServer returns a Server wrapping ctrl. calls is the channel controller.Run publishes to;
+// Server takes ownership of fanning it out to Stream subscribers, so a caller that also needs to
+// consume calls itself (e.g. for text-to-speech) should tee the channel before passing it here.
+// cmp renders the natural-language subtitle and speech for each published Call; it should be the
+// same Composer the bot uses for SRS voice playback, so gRPC subscribers hear the same wording.
+func NewServer(ctrl controller.Controller, calls <-chan controller.Call, cmp composer.Composer) *Server {
+	s := &Server{ctrl: ctrl, composer: cmp, broadcaster: newBroadcaster()}
+	go s.broadcaster.run(calls)
+	return s
+}
+
+// ListenAndServe starts a gRPC server on addr exposing SkyeyeService, gated by a shared-token
+// auth interceptor so the endpoint can be exposed on non-loopback interfaces safely. authToken
+// must be non-empty: ListenAndServe refuses to start otherwise, since an empty token would
+// otherwise grant anonymous control of the bot to anyone who can reach addr. It blocks until ctx
+// is canceled or the listener fails.
+func (s *Server) ListenAndServe(ctx context.Context, addr string, authToken string) error {
+	if authToken == "" {
+		return fmt.Errorf("grpcapi: authToken must not be empty")
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("grpcapi: listening on %s: %w", addr, err)
+	}
+
+	auth := tokenAuth{token: authToken}
+	options := append([]grpc.ServerOption{grpc.ForceServerCodec(codec{})}, auth.ServerOptions()...)
+	grpcServer := grpc.NewServer(options...)
+	RegisterSkyeyeServiceServer(grpcServer, s)
+
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+
+	log.Info().Str("addr", addr).Msg("serving gRPC control-plane API")
+	return grpcServer.Serve(listener)
+}
+
+// RequestPicture implements [SkyeyeServiceServer.RequestPicture].
+func (s *Server) RequestPicture(ctx context.Context, req *RequestPictureRequest) (*Call, error) {
+	traceID := traceIDOrNew(req.TraceID)
+	s.ctrl.RequestPicture(traces.WithTraceID(ctx, traceID))
+	// The composed picture itself is published asynchronously and delivered to Stream
+	// subscribers; this unary RPC only acknowledges that the request was accepted.
+	return &Call{TraceID: traceID, Kind: "picture_requested"}, nil
+}
+
+// SetThreatMonitoring implements [SkyeyeServiceServer.SetThreatMonitoring].
+func (s *Server) SetThreatMonitoring(_ context.Context, req *SetThreatMonitoringRequest) (*SetThreatMonitoringResponse, error) {
+	s.ctrl.SetThreatMonitoring(req.Enabled, time.Duration(req.CooldownSeconds)*time.Second)
+	return &SetThreatMonitoringResponse{Enabled: req.Enabled, CooldownSeconds: req.CooldownSeconds}, nil
+}
+
+// Stream implements [SkyeyeServiceServer.Stream].
+func (s *Server) Stream(stream SkyeyeService_StreamServer) error {
+	queue, unsubscribe := s.broadcaster.subscribe()
+	defer unsubscribe()
+
+	ctx := stream.Context()
+	recvErrs := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErrs <- err
+				return
+			}
+			s.dispatch(ctx, req)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-recvErrs:
+			return err
+		case call, ok := <-queue:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(s.toWireCall(call)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// toWireCall converts a controller.Call into its gRPC wire representation, rendering the
+// natural-language subtitle and speech with s.composer so that gRPC subscribers receive the same
+// wording a player hears over SRS.
+//
+// Kind and the Compose* method to call are only known for the payload types handled below;
+// everything else (e.g. the eventual Handle* responses not yet wired through this package) falls
+// back to a kind derived from the Go type name and an empty subtitle/speech, so new call types
+// show up as something diagnosable instead of silently losing their trace ID.
+func (s *Server) toWireCall(call controller.Call) *Call {
+	out := &Call{TraceID: traces.TraceID(call.Context)}
+
+	switch payload := call.Call.(type) {
+	case brevity.SunriseCall:
+		out.Kind = "sunrise"
+	case brevity.ThreatCall:
+		out.Kind = "threat"
+		if s.composer != nil {
+			nlr := s.composer.ComposeThreatCall(call.Context, payload)
+			out.Subtitle, out.Speech = nlr.Subtitle, nlr.Speech
+		}
+	case brevity.AlphaCheckResponse:
+		out.Kind = "alpha_check"
+		if s.composer != nil {
+			nlr := s.composer.ComposeAlphaCheckResponse(call.Context, payload)
+			out.Subtitle, out.Speech = nlr.Subtitle, nlr.Speech
+		}
+	case brevity.NegativeRadarContactResponse:
+		out.Kind = "negative_radar_contact"
+		if s.composer != nil {
+			nlr := s.composer.ComposeNegativeRadarContactResponse(call.Context, payload)
+			out.Subtitle, out.Speech = nlr.Subtitle, nlr.Speech
+		}
+	default:
+		out.Kind = fmt.Sprintf("%T", call.Call)
+	}
+	return out
+}
+
+// dispatch converts req into the matching brevity request and invokes the corresponding
+// controller Handle* method with a synthesized context carrying req's trace ID.
+func (s *Server) dispatch(ctx context.Context, req *BrevityRequest) {
+	ctx = traces.WithTraceID(ctx, traceIDOrNew(req.TraceID))
+
+	switch req.Kind {
+	case "alpha_check":
+		s.ctrl.HandleAlphaCheck(ctx, &brevity.AlphaCheckRequest{Callsign: req.Callsign})
+	case "bogey_dope":
+		s.ctrl.HandleBogeyDope(ctx, &brevity.BogeyDopeRequest{Callsign: req.Callsign})
+	case "declare":
+		s.ctrl.HandleDeclare(ctx, &brevity.DeclareRequest{Callsign: req.Callsign, BearingDegrees: req.BearingDegrees, RangeNM: req.RangeNM})
+	case "snaplock":
+		s.ctrl.HandleSnaplock(ctx, &brevity.SnaplockRequest{Callsign: req.Callsign, BearingDegrees: req.BearingDegrees, RangeNM: req.RangeNM})
+	case "spiked":
+		s.ctrl.HandleSpiked(ctx, &brevity.SpikedRequest{Callsign: req.Callsign, BearingDegrees: req.BearingDegrees})
+	case "picture":
+		s.ctrl.HandlePicture(ctx, &brevity.PictureRequest{Callsign: req.Callsign})
+	case "radio_check":
+		s.ctrl.HandleRadioCheck(ctx, &brevity.RadioCheckRequest{Callsign: req.Callsign})
+	case "tripwire":
+		s.ctrl.HandleTripwire(ctx, &brevity.TripwireRequest{Callsign: req.Callsign})
+	default:
+		log.Warn().Str("kind", req.Kind).Msg("gRPC stream sent unknown BrevityRequest kind")
+	}
+}
+
+func traceIDOrNew(traceID string) string {
+	if traceID != "" {
+		return traceID
+	}
+	return shortuuid.New()
+}
@@ -0,0 +1,109 @@
+// Package grpcapi exposes controller.Controller over gRPC, so external tools (mission scripts,
+// coach panels, web dashboards) can subscribe to broadcasts and inject requests without going
+// through SRS voice. The service is defined in api/v1/skyeye.proto.
+//
+// This package implements the service directly against grpc-go rather than through
+// protoc-generated stubs: ServiceDesc below, and the hand-rolled messages in messages.go plus the
+// codec in codec.go, stand in for protoc-gen-go-grpc/protoc-gen-go output. See the doc comment on
+// codec in codec.go for why, and for why this is still wire-compatible with a real
+// protoc-generated client despite the stand-in.
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// SkyeyeServiceServer is the server API for SkyeyeService, as defined in api/v1/skyeye.proto.
+type SkyeyeServiceServer interface {
+	// Stream handles a bidirectional client connection: BrevityRequests in, Calls out.
+	Stream(SkyeyeService_StreamServer) error
+	// RequestPicture handles a one-shot PICTURE request.
+	RequestPicture(context.Context, *RequestPictureRequest) (*Call, error)
+	// SetThreatMonitoring enables or disables automatic threat calls at runtime.
+	SetThreatMonitoring(context.Context, *SetThreatMonitoringRequest) (*SetThreatMonitoringResponse, error)
+}
+
+// SkyeyeService_StreamServer is the server-side stream handle for the Stream RPC.
+type SkyeyeService_StreamServer interface {
+	Send(*Call) error
+	Recv() (*BrevityRequest, error)
+	grpc.ServerStream
+}
+
+type skyeyeServiceStreamServer struct {
+	grpc.ServerStream
+}
+
+func (s *skyeyeServiceStreamServer) Send(call *Call) error {
+	return s.ServerStream.SendMsg(call)
+}
+
+func (s *skyeyeServiceStreamServer) Recv() (*BrevityRequest, error) {
+	req := new(BrevityRequest)
+	if err := s.ServerStream.RecvMsg(req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func skyeyeServiceStreamHandler(srv any, stream grpc.ServerStream) error {
+	return srv.(SkyeyeServiceServer).Stream(&skyeyeServiceStreamServer{ServerStream: stream})
+}
+
+func skyeyeServiceRequestPictureHandler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	req := new(RequestPictureRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkyeyeServiceServer).RequestPicture(ctx, req)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/skyeye.v1.SkyeyeService/RequestPicture"}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(SkyeyeServiceServer).RequestPicture(ctx, req.(*RequestPictureRequest))
+	}
+	return interceptor(ctx, req, info, handler)
+}
+
+func skyeyeServiceSetThreatMonitoringHandler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	req := new(SetThreatMonitoringRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkyeyeServiceServer).SetThreatMonitoring(ctx, req)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/skyeye.v1.SkyeyeService/SetThreatMonitoring"}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(SkyeyeServiceServer).SetThreatMonitoring(ctx, req.(*SetThreatMonitoringRequest))
+	}
+	return interceptor(ctx, req, info, handler)
+}
+
+// ServiceDesc is the grpc.ServiceDesc for SkyeyeService. protoc-gen-go-grpc would normally
+// generate this from api/v1/skyeye.proto; see the package doc comment for why it's hand-written
+// here instead.
+var ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "skyeye.v1.SkyeyeService",
+	HandlerType: (*SkyeyeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "RequestPicture", Handler: skyeyeServiceRequestPictureHandler},
+		{MethodName: "SetThreatMonitoring", Handler: skyeyeServiceSetThreatMonitoringHandler},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       skyeyeServiceStreamHandler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "api/v1/skyeye.proto",
+}
+
+// RegisterSkyeyeServiceServer registers srv as the implementation of SkyeyeService on s.
+func RegisterSkyeyeServiceServer(s *grpc.Server, srv SkyeyeServiceServer) {
+	s.RegisterService(&ServiceDesc, srv)
+}
@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"io"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Start wires up the global metrics sink from the bot's --metrics-listen and --statsd-addr
+// flags. If both are empty, metrics stay disabled and [Noop] remains the global sink. If both
+// are set, metrics are recorded to both destinations.
+//
+// The returned closer closes the statsd connection, if Start opened one, and should be closed on
+// shutdown. It does not stop the Prometheus HTTP server. It is a no-op if --statsd-addr was not
+// set.
+func Start(metricsListenAddr, statsdAddr string) (io.Closer, error) {
+	var sinks []Sink
+	closer := nopCloser{}
+
+	if metricsListenAddr != "" {
+		prom := NewPrometheusSink()
+		sinks = append(sinks, prom)
+		go func() {
+			if err := prom.ListenAndServe(metricsListenAddr); err != nil {
+				log.Error().Err(err).Str("addr", metricsListenAddr).Msg("metrics HTTP server stopped")
+			}
+		}()
+		log.Info().Str("addr", metricsListenAddr).Msg("serving Prometheus metrics")
+	}
+
+	if statsdAddr != "" {
+		statsd, err := NewStatsdSink(statsdAddr)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, statsd)
+		closer.c = statsd
+		log.Info().Str("addr", statsdAddr).Msg("pushing metrics to statsd")
+	}
+
+	switch len(sinks) {
+	case 0:
+		SetGlobalSink(Noop)
+	case 1:
+		SetGlobalSink(sinks[0])
+	default:
+		SetGlobalSink(multiSink(sinks))
+	}
+
+	return closer, nil
+}
+
+// multiSink fans out every metric to each of its member sinks.
+type multiSink []Sink
+
+func (m multiSink) SetGauge(key []string, val float32) {
+	for _, sink := range m {
+		sink.SetGauge(key, val)
+	}
+}
+
+func (m multiSink) IncrCounter(key []string, val float32) {
+	for _, sink := range m {
+		sink.IncrCounter(key, val)
+	}
+}
+
+func (m multiSink) AddSample(key []string, val float32) {
+	for _, sink := range m {
+		sink.AddSample(key, val)
+	}
+}
+
+// nopCloser wraps an optional io.Closer, closing it only if set.
+type nopCloser struct {
+	c io.Closer
+}
+
+func (n nopCloser) Close() error {
+	if n.c == nil {
+		return nil
+	}
+	return n.c.Close()
+}
@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"strings"
+	"sync"
+)
+
+// InmemSink stores metrics in memory. It is primarily useful for tests and debugging; operators
+// running in production will usually prefer [NewPrometheusSink] or [NewStatsdSink].
+type InmemSink struct {
+	mu       sync.Mutex
+	gauges   map[string]float32
+	counters map[string]float32
+	samples  map[string][]float32
+}
+
+// NewInmemSink returns an empty [InmemSink].
+func NewInmemSink() *InmemSink {
+	return &InmemSink{
+		gauges:   make(map[string]float32),
+		counters: make(map[string]float32),
+		samples:  make(map[string][]float32),
+	}
+}
+
+// SetGauge implements [Sink.SetGauge].
+func (s *InmemSink) SetGauge(key []string, val float32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gauges[join(key)] = val
+}
+
+// IncrCounter implements [Sink.IncrCounter].
+func (s *InmemSink) IncrCounter(key []string, val float32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[join(key)] += val
+}
+
+// AddSample implements [Sink.AddSample].
+func (s *InmemSink) AddSample(key []string, val float32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := join(key)
+	s.samples[k] = append(s.samples[k], val)
+}
+
+// Gauges returns a snapshot of every gauge value recorded so far, keyed by the dot-joined metric
+// key.
+func (s *InmemSink) Gauges() map[string]float32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneFloatMap(s.gauges)
+}
+
+// Counters returns a snapshot of every counter value recorded so far, keyed by the dot-joined
+// metric key.
+func (s *InmemSink) Counters() map[string]float32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneFloatMap(s.counters)
+}
+
+// Samples returns a snapshot of every histogram sample recorded so far, keyed by the dot-joined
+// metric key.
+func (s *InmemSink) Samples() map[string][]float32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[string][]float32, len(s.samples))
+	for k, v := range s.samples {
+		out[k] = append([]float32(nil), v...)
+	}
+	return out
+}
+
+func join(key []string) string {
+	return strings.Join(key, ".")
+}
+
+func cloneFloatMap(m map[string]float32) map[string]float32 {
+	out := make(map[string]float32, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
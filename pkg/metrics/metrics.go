@@ -0,0 +1,59 @@
+// Package metrics provides a pluggable metrics sink for the controller, parser, composer, and
+// SRS audio pipeline, modeled on the sink interface popularized by armon/go-metrics. Callers
+// record counters, gauges, and timing samples against keys built from a base name plus labels,
+// e.g. metrics.IncrCounter([]string{"controller", "requests", "alpha_check"}, 1).
+//
+// The default sink is [Noop], which discards every metric until an operator attaches a real sink
+// with [SetGlobalSink]. Call sites still heap-allocate their key slices while Noop is attached,
+// since each key is passed through the [Sink] interface. [NewInmemSink] is useful for tests and debugging;
+// [NewStatsdSink] pushes metrics to a statsd daemon; [NewPrometheusSink] serves them over HTTP in
+// the Prometheus text exposition format.
+package metrics
+
+import "time"
+
+// Sink records metrics. Implementations must be safe for concurrent use, since metrics are
+// recorded from the controller, composer, parser, and audio hot paths concurrently.
+type Sink interface {
+	// SetGauge sets the current value of a gauge identified by key.
+	SetGauge(key []string, val float32)
+	// IncrCounter increments a counter identified by key by val.
+	IncrCounter(key []string, val float32)
+	// AddSample adds a sample to a histogram identified by key, e.g. a latency or count
+	// distribution.
+	AddSample(key []string, val float32)
+}
+
+var global Sink = Noop
+
+// SetGlobalSink attaches sink as the destination for metrics recorded via the package-level
+// IncrCounter, SetGauge, AddSample, and MeasureSince functions. Pass [Noop] to detach.
+func SetGlobalSink(sink Sink) {
+	if sink == nil {
+		sink = Noop
+	}
+	global = sink
+}
+
+// IncrCounter increments a counter identified by key by val, on the global sink.
+func IncrCounter(key []string, val float32) {
+	global.IncrCounter(key, val)
+}
+
+// SetGauge sets the current value of a gauge identified by key, on the global sink.
+func SetGauge(key []string, val float32) {
+	global.SetGauge(key, val)
+}
+
+// AddSample adds a sample to a histogram identified by key, on the global sink.
+func AddSample(key []string, val float32) {
+	global.AddSample(key, val)
+}
+
+// MeasureSince adds a sample of the elapsed time since start, in milliseconds, to a histogram
+// identified by key, on the global sink. It is typically used with defer:
+//
+//	defer metrics.MeasureSince([]string{"controller", "requests", "alpha_check"}, time.Now())
+func MeasureSince(key []string, start time.Time) {
+	global.AddSample(key, float32(time.Since(start).Milliseconds()))
+}
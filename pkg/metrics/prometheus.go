@@ -0,0 +1,102 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// PrometheusSink accumulates metrics in memory and serves them over HTTP in the Prometheus text
+// exposition format. Gauges and counters are reported as-is; histogram samples are reported as
+// Prometheus summaries with count and sum, since the bot's metric volume doesn't warrant
+// configurable bucket boundaries.
+type PrometheusSink struct {
+	mu       sync.Mutex
+	gauges   map[string]float32
+	counters map[string]float32
+	sums     map[string]float64
+	counts   map[string]uint64
+}
+
+// NewPrometheusSink returns an empty [PrometheusSink]. Attach its [PrometheusSink.Handler] to an
+// HTTP server, e.g. with the --metrics-listen flag.
+func NewPrometheusSink() *PrometheusSink {
+	return &PrometheusSink{
+		gauges:   make(map[string]float32),
+		counters: make(map[string]float32),
+		sums:     make(map[string]float64),
+		counts:   make(map[string]uint64),
+	}
+}
+
+// SetGauge implements [Sink.SetGauge].
+func (s *PrometheusSink) SetGauge(key []string, val float32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gauges[metricName(key)] = val
+}
+
+// IncrCounter implements [Sink.IncrCounter].
+func (s *PrometheusSink) IncrCounter(key []string, val float32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[metricName(key)] += val
+}
+
+// AddSample implements [Sink.AddSample].
+func (s *PrometheusSink) AddSample(key []string, val float32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := metricName(key)
+	s.sums[name] += float64(val)
+	s.counts[name]++
+}
+
+// metricName dot-joins key into a Prometheus metric name, replacing dots and hyphens with
+// underscores. Other characters are passed through unchanged.
+func metricName(key []string) string {
+	return strings.NewReplacer(".", "_", "-", "_").Replace(join(key))
+}
+
+// Handler returns an [http.Handler] that serves the current metrics in the Prometheus text
+// exposition format.
+func (s *PrometheusSink) Handler() http.Handler {
+	return http.HandlerFunc(s.ServeHTTP)
+}
+
+// ServeHTTP implements [http.Handler].
+func (s *PrometheusSink) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	for _, name := range sortedKeys(s.gauges) {
+		fmt.Fprintf(w, "# TYPE %s gauge\n%s %f\n", name, name, s.gauges[name])
+	}
+	for _, name := range sortedKeys(s.counters) {
+		fmt.Fprintf(w, "# TYPE %s counter\n%s %f\n", name, name, s.counters[name])
+	}
+	for _, name := range sortedKeys(s.counts) {
+		fmt.Fprintf(w, "# TYPE %s summary\n%s_sum %f\n%s_count %d\n", name, name, s.sums[name], name, s.counts[name])
+	}
+}
+
+// ListenAndServe starts an HTTP server on addr serving s at "/metrics". It blocks until the
+// server stops, so callers typically invoke it in its own goroutine.
+func (s *PrometheusSink) ListenAndServe(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", s.Handler())
+	return http.ListenAndServe(addr, mux) //nolint:gosec // operator-controlled listen address, not a public endpoint
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
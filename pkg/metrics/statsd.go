@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/rs/zerolog/log"
+)
+
+// StatsdSink pushes metrics to a statsd daemon over UDP in the conventional
+// "key:value|type" wire format. Writes are fire-and-forget: a statsd outage should never slow
+// down or block the caller.
+type StatsdSink struct {
+	conn net.Conn
+}
+
+// NewStatsdSink returns a [Sink] that pushes metrics to the statsd daemon at addr.
+func NewStatsdSink(addr string) (*StatsdSink, error) {
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("metrics: dialing statsd sink %s: %w", addr, err)
+	}
+	return &StatsdSink{conn: conn}, nil
+}
+
+// SetGauge implements [Sink.SetGauge].
+func (s *StatsdSink) SetGauge(key []string, val float32) {
+	s.send(key, val, "g")
+}
+
+// IncrCounter implements [Sink.IncrCounter].
+func (s *StatsdSink) IncrCounter(key []string, val float32) {
+	s.send(key, val, "c")
+}
+
+// AddSample implements [Sink.AddSample].
+func (s *StatsdSink) AddSample(key []string, val float32) {
+	s.send(key, val, "ms")
+}
+
+func (s *StatsdSink) send(key []string, val float32, statsdType string) {
+	line := fmt.Sprintf("%s:%f|%s", join(key), val, statsdType)
+	if _, err := s.conn.Write([]byte(line)); err != nil {
+		log.Warn().Err(err).Str("metric", join(key)).Msg("failed to push metric to statsd")
+	}
+}
+
+// Close closes the underlying connection to the statsd daemon.
+func (s *StatsdSink) Close() error {
+	return s.conn.Close()
+}
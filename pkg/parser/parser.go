@@ -0,0 +1,69 @@
+package parser
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dharmab/skyeye/pkg/traces"
+)
+
+// Recognized intents, named to match the brevity request type a transcript matching one should
+// be dispatched to.
+const (
+	alphaCheck = "ALPHA CHECK"
+	bogeyDope  = "BOGEY DOPE"
+	checkIn    = "CHECK IN"
+	declare    = "DECLARE"
+	picture    = "PICTURE"
+	radioCheck = "RADIO CHECK"
+	snaplock   = "SNAPLOCK"
+	spiked     = "SPIKED"
+	tripwire   = "TRIPWIRE"
+)
+
+// canonicalRequestWords maps each intent's own canonical phrasing to itself, so Parse can look
+// keywords up in the same shape of table as alternateRequestWords.
+var canonicalRequestWords = map[string]string{
+	"alpha check": alphaCheck,
+	"bogey dope":  bogeyDope,
+	"check in":    checkIn,
+	"declare":     declare,
+	"picture":     picture,
+	"radio check": radioCheck,
+	"snaplock":    snaplock,
+	"spiked":      spiked,
+	"tripwire":    tripwire,
+}
+
+// Parse matches transcript against the known request words, canonical first and then the
+// misheard/alternate forms in alternateRequestWords, and reports the intent it found, if any. It
+// always reports the outcome to the attached tap sink via emitRequestParsed, matched or not, so
+// an operator can audit transcripts the parser failed to understand.
+//
+// Parse only recognizes intent; it does not extract a callsign or build the matching
+// brevity.*Request itself, since that requires matching against the active callsign roster,
+// which belongs to the caller (e.g. the controller's radar scope), not this package. callsign is
+// whatever the caller already associated with the transmission; it is reported to the tap sink
+// as-is and otherwise unused here.
+func Parse(ctx context.Context, transcript, callsign string) (intent string, ok bool) {
+	normalized := strings.ToLower(transcript)
+
+	confidence := 0.0
+	for phrase, word := range canonicalRequestWords {
+		if strings.Contains(normalized, phrase) {
+			intent, ok, confidence = word, true, 1
+			break
+		}
+	}
+	if !ok {
+		for phrase, word := range alternateRequestWords {
+			if strings.Contains(normalized, phrase) {
+				intent, ok, confidence = word, true, 0.5
+				break
+			}
+		}
+	}
+
+	emitRequestParsed(traces.TraceID(ctx), transcript, callsign, intent, confidence)
+	return intent, ok
+}
@@ -0,0 +1,45 @@
+package parser
+
+import (
+	"time"
+
+	"github.com/dharmab/skyeye/pkg/tap"
+	"github.com/rs/zerolog/log"
+)
+
+// tapSink receives a copy of every parsed transcript, for offline analysis. It defaults to
+// [tap.Noop] so parsing costs nothing extra until an operator attaches a sink.
+var tapSink tap.Sink = tap.Noop
+
+// SetTapSink attaches sink as the destination for [tap.RequestParsed] events. Pass [tap.Noop] to
+// detach.
+func SetTapSink(sink tap.Sink) {
+	if sink == nil {
+		sink = tap.Noop
+	}
+	tapSink = sink
+}
+
+// emitRequestParsed reports a parsed (or unparseable) transcript to the attached tap sink, if
+// any. traceID and confidence may be zero values when the caller doesn't have them; intent
+// should be empty when the transcript could not be matched to a brevity request.
+//
+// Called by [Parse] once per transcript it handles.
+func emitRequestParsed(traceID, transcript, callsign, intent string, confidence float64) {
+	if !tap.IsEnabled(tapSink) {
+		return
+	}
+	if err := tapSink.Write(tap.Frame{
+		Type:      tap.FrameTypeRequestParsed,
+		Timestamp: time.Now(),
+		Payload: tap.RequestParsed{
+			TraceID:    traceID,
+			Transcript: transcript,
+			Callsign:   callsign,
+			Intent:     intent,
+			Confidence: confidence,
+		}.Marshal(),
+	}); err != nil {
+		log.Warn().Err(err).Msg("failed to write tap frame")
+	}
+}
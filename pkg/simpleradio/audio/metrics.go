@@ -0,0 +1,23 @@
+package audio
+
+import "github.com/dharmab/skyeye/pkg/metrics"
+
+// recordPacketReceived increments the counter tracking raw voice packets received off the wire,
+// before they're decoded.
+func recordPacketReceived() {
+	metrics.IncrCounter([]string{"audio", "packets", "received"}, 1)
+}
+
+// recordPacketDecoded increments the counter tracking voice packets successfully decoded, and
+// adds the packet's retransmission count to a histogram so operators can detect lossy SRS
+// servers.
+func recordPacketDecoded(p VoicePacket) {
+	metrics.IncrCounter([]string{"audio", "packets", "decoded"}, 1)
+	metrics.AddSample([]string{"audio", "packets", "retransmissions"}, float32(p.RetransmissionCount))
+}
+
+// recordPacketDropped increments the counter tracking voice packets that could not be decoded,
+// e.g. because they were too short to contain a complete fixed header.
+func recordPacketDropped() {
+	metrics.IncrCounter([]string{"audio", "packets", "dropped"}, 1)
+}
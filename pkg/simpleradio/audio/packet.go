@@ -46,8 +46,16 @@ type VoicePacket struct {
 	GUID []byte
 }
 
-// newVoicePacketFrom converts a voice packet from bytes to struct
+// newVoicePacketFrom converts a voice packet from bytes to struct. Packets too short to contain a
+// full fixed header are dropped rather than risking a panic on an out-of-range slice.
 func newVoicePacketFrom(b []byte) VoicePacket {
+	recordPacketReceived()
+
+	if len(b) < 6 {
+		recordPacketDropped()
+		return VoicePacket{}
+	}
+
 	p := VoicePacket{
 		PacketLength:             binary.BigEndian.Uint16(b[0:2]),
 		AudioSegmentLength:       binary.BigEndian.Uint16(b[2:4]),
@@ -58,12 +66,17 @@ func newVoicePacketFrom(b []byte) VoicePacket {
 	audioBytesOffset := audioSegmentOffset + 2
 	frequenciesOffset := audioBytesOffset + int(p.AudioLength)
 	fixedSegmentOffset := frequenciesOffset + int(p.FrequenciesSegmentLength)
+	guidOffset := fixedSegmentOffset + 4 /* UnitID */ + 8 /* PacketID */ + 1 /* RetransmissionCount */ + 22 /* OriginalGUID */
+
+	if len(b) < guidOffset+22 {
+		recordPacketDropped()
+		return VoicePacket{}
+	}
 
 	p.AudioLength = binary.BigEndian.Uint16(b[audioSegmentOffset:audioBytesOffset])
 	p.AudioBytes = b[audioBytesOffset:frequenciesOffset]
 
-	for i := frequenciesOffset; i <= frequenciesOffset+int(p.FrequenciesSegmentLength); {
-
+	for i := frequenciesOffset; i+10 <= frequenciesOffset+int(p.FrequenciesSegmentLength) && i+10 <= len(b); i += 10 {
 		frequency := srs.Frequency{
 			Frequency:  math.Float64frombits(binary.BigEndian.Uint64(b[i : i+8])),
 			Modulation: b[i+8],
@@ -77,7 +90,6 @@ func newVoicePacketFrom(b []byte) VoicePacket {
 	packetIDOffset := unitIDOffset + 4
 	retrasmissionCountOffset := packetIDOffset + 8
 	originalGUIDOffset := retrasmissionCountOffset + 1
-	guidOffset := originalGUIDOffset + 22
 
 	p.UnitID = binary.BigEndian.Uint32(b[unitIDOffset:packetIDOffset])
 	p.PacketID = binary.BigEndian.Uint64(b[packetIDOffset:retrasmissionCountOffset])
@@ -85,5 +97,8 @@ func newVoicePacketFrom(b []byte) VoicePacket {
 	p.OriginalGUID = b[originalGUIDOffset:guidOffset]
 	p.GUID = b[guidOffset : guidOffset+22]
 
+	recordPacketDecoded(p)
+	emitVoicePacket(p)
+
 	return p
-}
\ No newline at end of file
+}
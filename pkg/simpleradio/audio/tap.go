@@ -0,0 +1,55 @@
+package audio
+
+import (
+	"time"
+
+	"github.com/dharmab/skyeye/pkg/tap"
+	"github.com/rs/zerolog/log"
+)
+
+// tapSink receives a copy of every received voice packet's metadata, for offline analysis. It
+// defaults to [tap.Noop] so decoding a packet costs nothing extra until an operator attaches a
+// sink.
+var tapSink tap.Sink = tap.Noop
+
+// includeAudioInTap controls whether VoicePacket.AudioBytes is populated when emitting tap
+// frames. It defaults to false, since operators may not want raw voice traffic leaving the host.
+var includeAudioInTap = false
+
+// SetTapSink attaches sink as the destination for [tap.VoicePacket] events. Pass [tap.Noop] to
+// detach. includeAudio controls whether the Opus payload itself is included in each event, in
+// addition to its metadata.
+func SetTapSink(sink tap.Sink, includeAudio bool) {
+	if sink == nil {
+		sink = tap.Noop
+	}
+	tapSink = sink
+	includeAudioInTap = includeAudio
+}
+
+// emitVoicePacket reports a received voice packet's metadata to the attached tap sink, if any.
+func emitVoicePacket(p VoicePacket) {
+	if !tap.IsEnabled(tapSink) {
+		return
+	}
+	frequencies := make([]float64, 0, len(p.Frequencies))
+	for _, f := range p.Frequencies {
+		frequencies = append(frequencies, f.Frequency)
+	}
+	msg := tap.VoicePacket{
+		Frequencies:         frequencies,
+		UnitID:              p.UnitID,
+		RetransmissionCount: uint32(p.RetransmissionCount),
+		PayloadLength:       uint32(p.AudioLength),
+	}
+	if includeAudioInTap {
+		msg.AudioBytes = p.AudioBytes
+	}
+	if err := tapSink.Write(tap.Frame{
+		Type:      tap.FrameTypeVoicePacket,
+		Timestamp: time.Now(),
+		Payload:   msg.Marshal(),
+	}); err != nil {
+		log.Warn().Err(err).Msg("failed to write tap frame")
+	}
+}
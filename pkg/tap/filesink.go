@@ -0,0 +1,38 @@
+package tap
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// fileSink writes frames to a file as a framestream, for offline analysis with the skyeye-tap
+// CLI or other tooling.
+type fileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens (creating if necessary) the file at path and returns a [Sink] that appends
+// frames to it.
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("tap: opening file sink %s: %w", path, err)
+	}
+	return &fileSink{file: file}, nil
+}
+
+// Write implements [Sink.Write].
+func (s *fileSink) Write(f Frame) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return writeFrame(s.file, f)
+}
+
+// Close implements [Sink.Close].
+func (s *fileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
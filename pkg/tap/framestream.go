@@ -0,0 +1,62 @@
+package tap
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// writeFrame writes f to w using a length-prefixed framestream: a 4-byte big-endian length,
+// followed by a 1-byte [FrameType], an 8-byte big-endian Unix nanosecond timestamp, and the
+// payload itself.
+func writeFrame(w io.Writer, f Frame) error {
+	header := make([]byte, 9)
+	header[0] = byte(f.Type)
+	binary.BigEndian.PutUint64(header[1:], uint64(f.Timestamp.UnixNano()))
+
+	length := uint32(len(header) + len(f.Payload))
+	lengthPrefix := make([]byte, 4)
+	binary.BigEndian.PutUint32(lengthPrefix, length)
+
+	if _, err := w.Write(lengthPrefix); err != nil {
+		return fmt.Errorf("tap: writing frame length: %w", err)
+	}
+	if _, err := w.Write(header); err != nil {
+		return fmt.Errorf("tap: writing frame header: %w", err)
+	}
+	if len(f.Payload) > 0 {
+		if _, err := w.Write(f.Payload); err != nil {
+			return fmt.Errorf("tap: writing frame payload: %w", err)
+		}
+	}
+	return nil
+}
+
+// ReadFrame reads and decodes a single frame written by [writeFrame]. It returns io.EOF when r
+// is exhausted between frames.
+func ReadFrame(r io.Reader) (Frame, error) {
+	lengthPrefix := make([]byte, 4)
+	if _, err := io.ReadFull(r, lengthPrefix); err != nil {
+		return Frame{}, err
+	}
+	length := binary.BigEndian.Uint32(lengthPrefix)
+	if length < 9 {
+		return Frame{}, fmt.Errorf("tap: frame too short: %d bytes", length)
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return Frame{}, fmt.Errorf("tap: reading frame body: %w", err)
+	}
+
+	return Frame{
+		Type:      FrameType(body[0]),
+		Timestamp: time.Unix(0, int64(binary.BigEndian.Uint64(body[1:9]))),
+		Payload:   body[9:],
+	}, nil
+}
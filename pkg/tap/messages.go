@@ -0,0 +1,174 @@
+package tap
+
+import (
+	"math"
+
+	"github.com/dharmab/skyeye/pkg/wire"
+)
+
+// RequestParsed mirrors skyeye.tap.v1.RequestParsed: a transcript that pkg/parser attempted to
+// match against a brevity request.
+type RequestParsed struct {
+	TraceID    string
+	Transcript string
+	Callsign   string
+	// Intent is the name of the matched brevity request (e.g. "BOGEY DOPE"), or empty if the
+	// transcript could not be matched.
+	Intent     string
+	Confidence float64
+}
+
+// Marshal encodes m per skyeye.tap.v1.RequestParsed.
+func (m RequestParsed) Marshal() []byte {
+	var buf []byte
+	buf = wire.AppendString(buf, 1, m.TraceID)
+	buf = wire.AppendString(buf, 2, m.Transcript)
+	buf = wire.AppendString(buf, 3, m.Callsign)
+	buf = wire.AppendString(buf, 4, m.Intent)
+	buf = wire.AppendDouble(buf, 5, m.Confidence)
+	return buf
+}
+
+// UnmarshalRequestParsed decodes b per skyeye.tap.v1.RequestParsed.
+func UnmarshalRequestParsed(b []byte) (RequestParsed, error) {
+	var m RequestParsed
+	err := wire.WalkFields(b, func(field, _ int, val []byte, uval uint64) error {
+		switch field {
+		case 1:
+			m.TraceID = string(val)
+		case 2:
+			m.Transcript = string(val)
+		case 3:
+			m.Callsign = string(val)
+		case 4:
+			m.Intent = string(val)
+		case 5:
+			m.Confidence = math.Float64frombits(uval)
+		}
+		return nil
+	})
+	return m, err
+}
+
+// ResponseComposed mirrors skyeye.tap.v1.ResponseComposed: a NaturalLanguageResponse produced by
+// a pkg/composer Compose* method.
+type ResponseComposed struct {
+	TraceID string
+	// Method is the name of the Composer method that produced the response, e.g.
+	// "ComposeAlphaCheckResponse".
+	Method   string
+	Subtitle string
+	Speech   string
+}
+
+// Marshal encodes m per skyeye.tap.v1.ResponseComposed.
+func (m ResponseComposed) Marshal() []byte {
+	var buf []byte
+	buf = wire.AppendString(buf, 1, m.TraceID)
+	buf = wire.AppendString(buf, 2, m.Method)
+	buf = wire.AppendString(buf, 3, m.Subtitle)
+	buf = wire.AppendString(buf, 4, m.Speech)
+	return buf
+}
+
+// UnmarshalResponseComposed decodes b per skyeye.tap.v1.ResponseComposed.
+func UnmarshalResponseComposed(b []byte) (ResponseComposed, error) {
+	var m ResponseComposed
+	err := wire.WalkFields(b, func(field, _ int, val []byte, _ uint64) error {
+		switch field {
+		case 1:
+			m.TraceID = string(val)
+		case 2:
+			m.Method = string(val)
+		case 3:
+			m.Subtitle = string(val)
+		case 4:
+			m.Speech = string(val)
+		}
+		return nil
+	})
+	return m, err
+}
+
+// ControllerBroadcast mirrors skyeye.tap.v1.ControllerBroadcast: an automatic picture, threat,
+// merge, or sunrise broadcast from controller.Run, not triggered by a specific player request.
+type ControllerBroadcast struct {
+	TraceID string
+	// Kind identifies the kind of broadcast, e.g. "picture", "threat", "merge", "sunrise".
+	Kind     string
+	Subtitle string
+	Speech   string
+}
+
+// Marshal encodes m per skyeye.tap.v1.ControllerBroadcast.
+func (m ControllerBroadcast) Marshal() []byte {
+	var buf []byte
+	buf = wire.AppendString(buf, 1, m.TraceID)
+	buf = wire.AppendString(buf, 2, m.Kind)
+	buf = wire.AppendString(buf, 3, m.Subtitle)
+	buf = wire.AppendString(buf, 4, m.Speech)
+	return buf
+}
+
+// UnmarshalControllerBroadcast decodes b per skyeye.tap.v1.ControllerBroadcast.
+func UnmarshalControllerBroadcast(b []byte) (ControllerBroadcast, error) {
+	var m ControllerBroadcast
+	err := wire.WalkFields(b, func(field, _ int, val []byte, _ uint64) error {
+		switch field {
+		case 1:
+			m.TraceID = string(val)
+		case 2:
+			m.Kind = string(val)
+		case 3:
+			m.Subtitle = string(val)
+		case 4:
+			m.Speech = string(val)
+		}
+		return nil
+	})
+	return m, err
+}
+
+// VoicePacket mirrors skyeye.tap.v1.VoicePacket: the metadata of a
+// pkg/simpleradio/audio.VoicePacket. AudioBytes is optional and gated by tap configuration.
+type VoicePacket struct {
+	Frequencies         []float64
+	UnitID              uint32
+	RetransmissionCount uint32
+	PayloadLength       uint32
+	AudioBytes          []byte
+}
+
+// Marshal encodes m per skyeye.tap.v1.VoicePacket.
+func (m VoicePacket) Marshal() []byte {
+	var buf []byte
+	for _, f := range m.Frequencies {
+		buf = wire.AppendDoubleAlways(buf, 1, f)
+	}
+	buf = wire.AppendVarint(buf, 2, uint64(m.UnitID))
+	buf = wire.AppendVarint(buf, 3, uint64(m.RetransmissionCount))
+	buf = wire.AppendVarint(buf, 4, uint64(m.PayloadLength))
+	buf = wire.AppendBytes(buf, 5, m.AudioBytes)
+	return buf
+}
+
+// UnmarshalVoicePacket decodes b per skyeye.tap.v1.VoicePacket.
+func UnmarshalVoicePacket(b []byte) (VoicePacket, error) {
+	var m VoicePacket
+	err := wire.WalkFields(b, func(field, _ int, val []byte, uval uint64) error {
+		switch field {
+		case 1:
+			m.Frequencies = append(m.Frequencies, math.Float64frombits(uval))
+		case 2:
+			m.UnitID = uint32(uval)
+		case 3:
+			m.RetransmissionCount = uint32(uval)
+		case 4:
+			m.PayloadLength = uint32(uval)
+		case 5:
+			m.AudioBytes = append([]byte(nil), val...)
+		}
+		return nil
+	})
+	return m, err
+}
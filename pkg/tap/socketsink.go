@@ -0,0 +1,47 @@
+package tap
+
+import (
+	"fmt"
+	"net"
+	"sync"
+)
+
+// socketSink writes frames to a streaming socket connection, such as a Unix domain socket or a
+// TCP connection to a remote collector. A single persistent connection is reused for all writes.
+type socketSink struct {
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewUnixSocketSink dials the Unix domain socket at path and returns a [Sink] that streams
+// frames to it.
+func NewUnixSocketSink(path string) (Sink, error) {
+	return newSocketSink("unix", path)
+}
+
+// NewTCPSocketSink dials addr over TCP and returns a [Sink] that streams frames to it.
+func NewTCPSocketSink(addr string) (Sink, error) {
+	return newSocketSink("tcp", addr)
+}
+
+func newSocketSink(network, address string) (Sink, error) {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, fmt.Errorf("tap: dialing %s socket sink %s: %w", network, address, err)
+	}
+	return &socketSink{conn: conn}, nil
+}
+
+// Write implements [Sink.Write].
+func (s *socketSink) Write(f Frame) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return writeFrame(s.conn, f)
+}
+
+// Close implements [Sink.Close].
+func (s *socketSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn.Close()
+}
@@ -0,0 +1,79 @@
+// Package tap implements a structured event tap for GCI calls and voice traffic, modeled on the
+// "dnstap" pattern used by DNS servers. When a [Sink] is attached, the controller, composer,
+// parser, and audio packages stream length-prefixed, protobuf-encoded frames describing
+// transcripts passed to parser.Parse, responses from the composer methods that report them (see
+// [ResponseComposed]), automatic controller broadcasts, and decoded voice packets, for offline
+// analysis, training data collection, or dashboards.
+//
+// The schema for each frame type is defined in api/tap/v1/tap.proto. Frames are delimited with
+// the framestream format implemented in framestream.go: a 4-byte big-endian length prefix
+// followed by the frame.
+package tap
+
+import "time"
+
+// FrameType identifies which event a [Frame] carries.
+type FrameType byte
+
+const (
+	// FrameTypeRequestParsed marks a frame carrying a [RequestParsed] message.
+	FrameTypeRequestParsed FrameType = iota + 1
+	// FrameTypeResponseComposed marks a frame carrying a [ResponseComposed] message.
+	FrameTypeResponseComposed
+	// FrameTypeControllerBroadcast marks a frame carrying a [ControllerBroadcast] message.
+	FrameTypeControllerBroadcast
+	// FrameTypeVoicePacket marks a frame carrying a [VoicePacket] message.
+	FrameTypeVoicePacket
+)
+
+// String implements [fmt.Stringer].
+func (t FrameType) String() string {
+	switch t {
+	case FrameTypeRequestParsed:
+		return "RequestParsed"
+	case FrameTypeResponseComposed:
+		return "ResponseComposed"
+	case FrameTypeControllerBroadcast:
+		return "ControllerBroadcast"
+	case FrameTypeVoicePacket:
+		return "VoicePacket"
+	default:
+		return "Unknown"
+	}
+}
+
+// Frame is a single framestream record emitted to a [Sink].
+type Frame struct {
+	// Type identifies which message Payload holds.
+	Type FrameType
+	// Timestamp is when the event was captured.
+	Timestamp time.Time
+	// Payload is the protobuf-encoded message, per the schema in api/tap/v1/tap.proto.
+	Payload []byte
+}
+
+// Sink accepts encoded tap frames for delivery to an operator-controlled destination, such as a
+// file or a socket. Implementations must be safe for concurrent use: frames are emitted from the
+// controller, composer, parser, and audio hot paths, which all run concurrently.
+type Sink interface {
+	// Write delivers a single frame. It should only block on the sink's own I/O, since callers
+	// are on the hot path.
+	Write(Frame) error
+	// Close releases any resources held by the sink.
+	Close() error
+}
+
+// noopSink is the default [Sink], used when no tap is attached. Callers should compare their
+// sink against [Noop] before building event payloads, so tap support costs nothing when disabled.
+type noopSink struct{}
+
+func (noopSink) Write(Frame) error { return nil }
+func (noopSink) Close() error      { return nil }
+
+// Noop is a [Sink] that discards every frame.
+var Noop Sink = noopSink{}
+
+// IsEnabled reports whether sink is an attached, non-discarding sink.
+func IsEnabled(sink Sink) bool {
+	return sink != nil && sink != Noop
+}
@@ -0,0 +1,128 @@
+// Package wire implements the small subset of the protobuf wire format that skyeye's hand-rolled
+// message types need: varint, fixed64, and length-delimited fields. It exists so packages like pkg/tap and
+// pkg/grpcapi can encode and decode a handful of stable, simple messages without depending on a
+// full protobuf runtime and code generator.
+package wire
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+const (
+	// Varint is the protobuf wire type for varint-encoded fields (int32, int64, bool, enums).
+	Varint = 0
+	// Fixed64 is the protobuf wire type for 64-bit fields (fixed64, sfixed64, double).
+	Fixed64 = 1
+	// Bytes is the protobuf wire type for length-delimited fields (string, bytes, embedded
+	// messages).
+	Bytes = 2
+)
+
+// AppendTag appends a field tag combining field and wireType.
+func AppendTag(buf []byte, field int, wireType int) []byte {
+	return binary.AppendUvarint(buf, uint64(field)<<3|uint64(wireType))
+}
+
+// AppendVarint appends field as a varint-typed field, omitting it entirely when v is zero, per
+// protobuf's default-value convention.
+func AppendVarint(buf []byte, field int, v uint64) []byte {
+	if v == 0 {
+		return buf
+	}
+	buf = AppendTag(buf, field, Varint)
+	return binary.AppendUvarint(buf, v)
+}
+
+// AppendDouble appends field as a fixed64-encoded double, per protobuf's wire format for the
+// `double` type, omitting it entirely when v is zero, per proto3's default-value convention for
+// singular scalar fields.
+func AppendDouble(buf []byte, field int, v float64) []byte {
+	if v == 0 {
+		return buf
+	}
+	return AppendDoubleAlways(buf, field, v)
+}
+
+// AppendDoubleAlways appends field as a fixed64-encoded double unconditionally, including when v
+// is zero. Use this for elements of a repeated double field: proto3 only elides the default value
+// of singular scalar fields, not individual elements of a repeated field, so eliding 0.0 elements
+// would silently corrupt the sequence.
+func AppendDoubleAlways(buf []byte, field int, v float64) []byte {
+	buf = AppendTag(buf, field, Fixed64)
+	return binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+}
+
+// AppendString appends field as a length-delimited field, omitting it entirely when s is empty.
+func AppendString(buf []byte, field int, s string) []byte {
+	if s == "" {
+		return buf
+	}
+	return AppendBytes(buf, field, []byte(s))
+}
+
+// AppendBytes appends field as a length-delimited field, omitting it entirely when b is empty.
+func AppendBytes(buf []byte, field int, b []byte) []byte {
+	if len(b) == 0 {
+		return buf
+	}
+	buf = AppendTag(buf, field, Bytes)
+	buf = binary.AppendUvarint(buf, uint64(len(b)))
+	return append(buf, b...)
+}
+
+// VisitField is called once per encoded field by WalkFields. val is populated for
+// length-delimited fields; uval is populated for varint and fixed64 fields.
+type VisitField func(field int, wireType int, val []byte, uval uint64) error
+
+// WalkFields parses a protobuf-encoded message, invoking visit for each field it finds. Unknown
+// field numbers are passed through to visit rather than rejected, so schemas can grow without
+// breaking older consumers.
+func WalkFields(b []byte, visit VisitField) error {
+	for len(b) > 0 {
+		tag, n := binary.Uvarint(b)
+		if n <= 0 {
+			return fmt.Errorf("wire: invalid field tag")
+		}
+		b = b[n:]
+		field := int(tag >> 3)
+		wireType := int(tag & 0x7)
+		switch wireType {
+		case Varint:
+			v, n := binary.Uvarint(b)
+			if n <= 0 {
+				return fmt.Errorf("wire: invalid varint for field %d", field)
+			}
+			b = b[n:]
+			if err := visit(field, wireType, nil, v); err != nil {
+				return err
+			}
+		case Fixed64:
+			if len(b) < 8 {
+				return fmt.Errorf("wire: truncated fixed64 field %d", field)
+			}
+			v := binary.LittleEndian.Uint64(b[:8])
+			b = b[8:]
+			if err := visit(field, wireType, nil, v); err != nil {
+				return err
+			}
+		case Bytes:
+			l, n := binary.Uvarint(b)
+			if n <= 0 {
+				return fmt.Errorf("wire: invalid length prefix for field %d", field)
+			}
+			b = b[n:]
+			if uint64(len(b)) < l {
+				return fmt.Errorf("wire: truncated field %d", field)
+			}
+			if err := visit(field, wireType, b[:l], 0); err != nil {
+				return err
+			}
+			b = b[l:]
+		default:
+			return fmt.Errorf("wire: unsupported wire type %d for field %d", wireType, field)
+		}
+	}
+	return nil
+}